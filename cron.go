@@ -0,0 +1,123 @@
+package checron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Songmu/checron/crontabparser"
+)
+
+// Cron returns a canonical 5-field crontab string reconstructed from the
+// schedule's expanded fields: contiguous runs collapse into "a-b", a
+// uniform gap is rendered as "*/n" or "a-b/n", and anything else is
+// emitted as a comma list.
+func (s *Schedule) Cron() string {
+	if s.interval > 0 {
+		return fmt.Sprintf("@every %s", s.interval)
+	}
+
+	minLo, minHi, _ := crontabparser.FieldRange("minute")
+	hourLo, hourHi, _ := crontabparser.FieldRange("hour")
+	domLo, domHi, _ := crontabparser.FieldRange("dom")
+	monthLo, monthHi, _ := crontabparser.FieldRange("month")
+	dowLo, _, _ := crontabparser.FieldRange("dow")
+	const dowHi = 6 // canonical dow output uses 0-6; 7 is Sunday's alias for 0
+
+	fields := []string{
+		formatField(s.entity.Minute.Expanded(), minLo, minHi),
+		formatField(s.entity.Hour.Expanded(), hourLo, hourHi),
+		formatField(s.entity.Dom.Expanded(), domLo, domHi),
+		formatField(s.entity.Month.Expanded(), monthLo, monthHi),
+		formatField(canonicalDow(s.entity.DayOfWeek.Expanded()), dowLo, dowHi),
+	}
+	return strings.Join(fields, " ")
+}
+
+// canonicalDow drops the alias entry for Sunday (7), which scheduleEntity
+// keeps alongside 0 so that Match(0) and Match(7) both succeed.
+func canonicalDow(values []int) []int {
+	out := make([]int, 0, len(values))
+	for _, v := range values {
+		if v == 7 {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+// formatField renders one field's expanded values back into crontab syntax.
+func formatField(values []int, lo, hi int) string {
+	if len(values) == 0 {
+		return "*"
+	}
+	if values[0] == lo && values[len(values)-1] == hi && len(values) == hi-lo+1 {
+		return "*"
+	}
+	// A step pattern must also be maximal: one more step would overshoot
+	// hi. Otherwise two evenly-spaced values (e.g. "1,15") would wrongly
+	// collapse into "*/14", which actually means "1,15,29,...".
+	if step, ok := uniformStep(values); ok && step > 1 && values[len(values)-1]+step > hi {
+		if values[0] == lo {
+			return fmt.Sprintf("*/%d", step)
+		}
+		return fmt.Sprintf("%d-%d/%d", values[0], values[len(values)-1], step)
+	}
+	return collapseRuns(values)
+}
+
+// uniformStep reports whether values form an arithmetic progression and, if
+// so, its common difference. Fewer than three values can't distinguish a
+// step from an arbitrary list or range (two values are always "uniform"),
+// so they're never reported as a step.
+func uniformStep(values []int) (step int, ok bool) {
+	if len(values) < 3 {
+		return 0, false
+	}
+	step = values[1] - values[0]
+	for i := 1; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0, false
+		}
+	}
+	return step, true
+}
+
+// collapseRuns joins values into a comma-separated list, collapsing any
+// run of consecutive integers into an "a-b" range.
+func collapseRuns(values []int) string {
+	var parts []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j > i {
+			parts = append(parts, fmt.Sprintf("%d-%d", values[i], values[j]))
+		} else {
+			parts = append(parts, strconv.Itoa(values[i]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+// contiguousBounds reports whether values form a single contiguous run and,
+// if so, its bounds.
+func contiguousBounds(values []int) (lo, hi int, ok bool) {
+	if len(values) == 0 {
+		return 0, 0, false
+	}
+	lo, hi = values[0], values[len(values)-1]
+	return lo, hi, len(values) == hi-lo+1
+}
+
+func monthName(m int) string {
+	return time.Month(m).String()
+}
+
+func weekdayName(d int) string {
+	return time.Weekday(d).String()
+}
@@ -0,0 +1,47 @@
+package crontabparser
+
+import "fmt"
+
+// Schedule holds the parsed fields of a crontab schedule line. Second is
+// nil unless the Schedule was built by a Parser configured to accept a
+// seconds field.
+type Schedule struct {
+	Second    *scheduleEntity
+	Minute    *scheduleEntity
+	Hour      *scheduleEntity
+	Dom       *scheduleEntity
+	Month     *scheduleEntity
+	DayOfWeek *scheduleEntity
+}
+
+// fieldTypeByName maps the field names used by checron.Parser to their
+// internal scheduleType.
+var fieldTypeByName = map[string]scheduleType{
+	"second": scheduleSecond,
+	"minute": scheduleMinute,
+	"hour":   scheduleHour,
+	"dom":    scheduleDay,
+	"month":  scheduleMonth,
+	"dow":    scheduleDayOfWeek,
+}
+
+// ParseEntity parses raw as the named schedule field ("second", "minute",
+// "hour", "dom", "month" or "dow").
+func ParseEntity(name, raw string) (*scheduleEntity, error) {
+	st, ok := fieldTypeByName[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown schedule field: %s", name)
+	}
+	return newScheduleEntity(raw, st)
+}
+
+// FieldRange returns the valid [min, max] bounds for the named schedule
+// field ("second", "minute", "hour", "dom", "month" or "dow").
+func FieldRange(name string) (min, max int, err error) {
+	st, ok := fieldTypeByName[name]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown schedule field: %s", name)
+	}
+	rng := entityParams[st].Range
+	return rng[0], rng[1], nil
+}
@@ -3,16 +3,20 @@ package crontabparser
 import (
 	"fmt"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 type scheduleEntity struct {
 	raw string
 	typ scheduleType
 
-	expanded []int
+	mask    uint64
+	starBit bool
+
+	expandOnce    sync.Once
+	expandedCache []int
 }
 
 func (se *scheduleEntity) Raw() string {
@@ -23,20 +27,31 @@ func (se *scheduleEntity) Type() scheduleType {
 	return se.typ
 }
 
+// StarBit reports whether the raw field was exactly "*", which governs the
+// OR-semantics used to combine day-of-month and day-of-week.
+func (se *scheduleEntity) StarBit() bool {
+	return se.starBit
+}
+
+// Expanded returns the sorted list of values this field matches, lazily
+// materialized from the underlying bit mask. Safe for concurrent use.
 func (se *scheduleEntity) Expanded() []int {
-	return se.expanded
+	se.expandOnce.Do(func() {
+		for i := 0; i < 64; i++ {
+			if se.mask&(1<<uint(i)) != 0 {
+				se.expandedCache = append(se.expandedCache, i)
+			}
+		}
+	})
+	return se.expandedCache
 }
 
+// Match reports whether num is one of the values this field matches.
 func (se *scheduleEntity) Match(num int) bool {
-	if se == nil {
+	if se == nil || num < 0 || num >= 64 {
 		return false
 	}
-	for _, i := range se.expanded {
-		if num == i {
-			return true
-		}
-	}
-	return false
+	return se.mask&(1<<uint(num)) != 0
 }
 
 //go:generate stringer -type=scheduleType -trimprefix Schedule
@@ -48,6 +63,7 @@ const (
 	scheduleDay
 	scheduleMonth
 	scheduleDayOfWeek
+	scheduleSecond
 )
 
 type entityParam struct {
@@ -73,6 +89,9 @@ var entityParams = map[scheduleType]entityParam{
 		Range:   [2]int{0, 7},
 		Aliases: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"},
 	},
+	scheduleSecond: {
+		Range: [2]int{0, 59},
+	},
 }
 
 func newScheduleEntity(raw string, st scheduleType) (*scheduleEntity, error) {
@@ -90,8 +109,10 @@ func newScheduleEntity(raw string, st scheduleType) (*scheduleEntity, error) {
 func (se *scheduleEntity) init() error {
 	ep, ok := entityParams[se.typ]
 	if !ok {
-		return fmt.Errorf("no entity param setting for %s", se.typ)
+		return fmt.Errorf("no entity param setting for %d", int(se.typ))
 	}
+	se.starBit = strings.TrimSpace(se.raw) == "*"
+
 	entity := strings.ToLower(se.raw)
 	for i, v := range ep.Aliases {
 		if v == "" {
@@ -99,10 +120,11 @@ func (se *scheduleEntity) init() error {
 		}
 		entity = strings.Replace(entity, v, fmt.Sprintf("%d", i), -1)
 	}
-	var expanded []int
+
+	var mask uint64
 	for _, item := range strings.Split(entity, ",") {
 		if stuffs := strings.SplitN(item, "/", 2); len(stuffs) == 2 {
-			rng, err := parseRange(stuffs[0], ep.Range)
+			rng, err := parseStepStart(stuffs[0], ep.Range)
 			if err != nil {
 				return fmt.Errorf("invalid entity: %s, %s", se.raw, err)
 			}
@@ -114,7 +136,7 @@ func (se *scheduleEntity) init() error {
 			incrCounter := 0
 			for i := rng[0]; i <= rng[1]; i++ {
 				if incrCounter%incr == 0 {
-					expanded = append(expanded, i)
+					mask |= 1 << uint(i)
 				}
 				incrCounter++
 			}
@@ -124,42 +146,40 @@ func (se *scheduleEntity) init() error {
 				if num < ep.Range[0] || num > ep.Range[1] {
 					return fmt.Errorf("invalid entity: %s", se.raw)
 				}
-				expanded = append(expanded, num)
+				mask |= 1 << uint(num)
 			} else {
 				rng, err := parseRange(item, ep.Range)
 				if err != nil {
 					return fmt.Errorf("invalid entity: %s, %s", se.raw, err)
 				}
 				for i := rng[0]; i <= rng[1]; i++ {
-					expanded = append(expanded, i)
+					mask |= 1 << uint(i)
 				}
 			}
 		}
 	}
 
-	if se.typ == scheduleDayOfWeek {
-		hasSun := false
-		for _, v := range expanded {
-			if v == 7 {
-				hasSun = true
-			}
-		}
-		if hasSun {
-			expanded = append(expanded, 0)
-		}
+	if se.typ == scheduleDayOfWeek && mask&(1<<7) != 0 {
+		mask |= 1 << 0
 	}
 
-	seen := make(map[int]struct{})
-	var uniqness []int
-	for _, v := range expanded {
-		if _, ok := seen[v]; !ok {
-			seen[v] = struct{}{}
-			uniqness = append(uniqness, v)
+	se.mask = mask
+	return nil
+}
+
+// parseStepStart parses the left-hand side of a "N/M" step expression. It
+// accepts everything parseRange does ("*" or "a-b"), plus a bare starting
+// value N, which expands to the range [N, rng[1]] per the usual crontab
+// convention for step expressions with a single starting value.
+func parseStepStart(item string, rng [2]int) (ret [2]int, err error) {
+	if n, err := strconv.ParseUint(item, 10, 64); err == nil {
+		num := int(n)
+		if num < rng[0] || num > rng[1] {
+			return ret, fmt.Errorf("invalid start: %s", item)
 		}
+		return [2]int{num, rng[1]}, nil
 	}
-	sort.Ints(uniqness)
-	se.expanded = uniqness
-	return nil
+	return parseRange(item, rng)
 }
 
 var rangeReg = regexp.MustCompile(`^(\d{1,2})-(\d{1,2})$`)
@@ -0,0 +1,98 @@
+package crontabparser
+
+import "testing"
+
+func TestScheduleEntity_MatchAndExpanded(t *testing.T) {
+	se, err := newScheduleEntity("1-5/2", scheduleMinute)
+	if err != nil {
+		t.Fatalf("newScheduleEntity: %v", err)
+	}
+	want := []int{1, 3, 5}
+	got := se.Expanded()
+	if len(got) != len(want) {
+		t.Fatalf("Expanded() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("Expanded()[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+	for _, v := range want {
+		if !se.Match(v) {
+			t.Errorf("Match(%d) = false, want true", v)
+		}
+	}
+	if se.Match(2) {
+		t.Error("Match(2) = true, want false")
+	}
+}
+
+func TestScheduleEntity_StarBit(t *testing.T) {
+	star, err := newScheduleEntity("*", scheduleDay)
+	if err != nil {
+		t.Fatalf("newScheduleEntity: %v", err)
+	}
+	if !star.StarBit() {
+		t.Error("StarBit() = false for \"*\", want true")
+	}
+
+	notStar, err := newScheduleEntity("1-5", scheduleDay)
+	if err != nil {
+		t.Fatalf("newScheduleEntity: %v", err)
+	}
+	if notStar.StarBit() {
+		t.Error("StarBit() = true for \"1-5\", want false")
+	}
+}
+
+func TestScheduleEntity_StepWithSingleStart(t *testing.T) {
+	cases := []struct {
+		raw  string
+		typ  scheduleType
+		want []int
+	}{
+		{"3/15", scheduleMinute, []int{3, 18, 33, 48}},
+		{"59/5", scheduleMinute, []int{59}},
+		{"10/6", scheduleHour, []int{10, 16, 22}},
+		{"20/10", scheduleDay, []int{20, 30}},
+		{"6/4", scheduleMonth, []int{6, 10}},
+		{"2/3", scheduleDayOfWeek, []int{2, 5}},
+	}
+	for _, c := range cases {
+		se, err := newScheduleEntity(c.raw, c.typ)
+		if err != nil {
+			t.Fatalf("newScheduleEntity(%q): %v", c.raw, err)
+		}
+		got := se.Expanded()
+		if len(got) != len(c.want) {
+			t.Fatalf("Expanded(%q) = %v, want %v", c.raw, got, c.want)
+		}
+		for i, v := range c.want {
+			if got[i] != v {
+				t.Errorf("Expanded(%q)[%d] = %d, want %d", c.raw, i, got[i], v)
+			}
+		}
+	}
+}
+
+func TestScheduleEntity_StepWithSingleStart_OutOfRange(t *testing.T) {
+	if _, err := newScheduleEntity("60/5", scheduleMinute); err == nil {
+		t.Error("expected error for out-of-range step start")
+	}
+	if _, err := newScheduleEntity("0/5", scheduleDay); err == nil {
+		t.Error("expected error for out-of-range step start (below minimum)")
+	}
+}
+
+func TestScheduleEntity_DayOfWeekSundayAlias(t *testing.T) {
+	se, err := newScheduleEntity("7", scheduleDayOfWeek)
+	if err != nil {
+		t.Fatalf("newScheduleEntity: %v", err)
+	}
+	if !se.Match(0) {
+		t.Error("Match(0) = false, want true (7 aliases to 0)")
+	}
+	if !se.Match(7) {
+		t.Error("Match(7) = false, want true")
+	}
+}
@@ -0,0 +1,166 @@
+package checron
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Translator renders a Schedule's fields as natural-language clauses.
+// Implement it to describe schedules in a language other than English.
+type Translator interface {
+	// At describes a single fixed fire time, e.g. "At 03:15".
+	At(hour, minute int) string
+	// EveryNMinutes describes a uniform per-hour cadence, e.g.
+	// "Every 15 minutes past the hour" (n == 1 means every minute).
+	EveryNMinutes(n int) string
+	// HourRange describes the hour span the schedule is restricted to,
+	// e.g. "between 09:00 and 17:00".
+	HourRange(fromHour, toHour int) string
+	// DayOfMonth describes a single day-of-month restriction, e.g.
+	// "on day-of-month 1".
+	DayOfMonth(day int) string
+	// Month describes a single month restriction, e.g. "in January".
+	Month(name string) string
+	// WeekdayRange describes a contiguous weekday span, e.g.
+	// "Monday through Friday".
+	WeekdayRange(from, to string) string
+	// List joins a set of weekday (or other) names, e.g. "Monday, Wednesday".
+	List(names []string) string
+	// Every describes a fixed "@every" interval, e.g. "Every 1h30m0s".
+	Every(spec string) string
+	// Generic is the fallback used when the minute/hour fields don't fit
+	// any of the other patterns; cron is the canonical crontab string.
+	Generic(cron string) string
+	// Join assembles the final sentence from its ordered clauses.
+	Join(clauses []string) string
+}
+
+// englishTranslator is the default, locale-neutral English Translator.
+type englishTranslator struct{}
+
+func (englishTranslator) At(hour, minute int) string {
+	return fmt.Sprintf("At %02d:%02d", hour, minute)
+}
+
+func (englishTranslator) EveryNMinutes(n int) string {
+	if n == 1 {
+		return "Every minute"
+	}
+	return fmt.Sprintf("Every %d minutes past the hour", n)
+}
+
+func (englishTranslator) HourRange(fromHour, toHour int) string {
+	return fmt.Sprintf("between %02d:00 and %02d:00", fromHour, toHour)
+}
+
+func (englishTranslator) DayOfMonth(day int) string {
+	return fmt.Sprintf("on day-of-month %d", day)
+}
+
+func (englishTranslator) Month(name string) string {
+	return fmt.Sprintf("in %s", name)
+}
+
+func (englishTranslator) WeekdayRange(from, to string) string {
+	return fmt.Sprintf("%s through %s", from, to)
+}
+
+func (englishTranslator) List(names []string) string {
+	return strings.Join(names, ", ")
+}
+
+func (englishTranslator) Every(spec string) string {
+	return fmt.Sprintf("Every %s", spec)
+}
+
+func (englishTranslator) Generic(cron string) string {
+	return fmt.Sprintf("At the times matching %q", cron)
+}
+
+func (englishTranslator) Join(clauses []string) string {
+	return strings.Join(clauses, ", ")
+}
+
+// Describe renders the schedule as an English sentence, e.g.
+// "At 03:15 on day-of-month 1 in January" or "Every 15 minutes past the
+// hour, between 09:00 and 17:00, Monday through Friday".
+func (s *Schedule) Describe() string {
+	return s.DescribeWith(englishTranslator{})
+}
+
+// DescribeWith renders the schedule using a custom Translator, allowing
+// callers to plug in another language.
+func (s *Schedule) DescribeWith(tr Translator) string {
+	if s.interval > 0 {
+		return tr.Every(s.interval.String())
+	}
+
+	// head is the clause describing the minute/hour pattern; rest holds
+	// the day-of-month/month/day-of-week restrictions. A fixed fire time
+	// reads naturally as one space-joined sentence ("At 03:15 on
+	// day-of-month 1 in January"), while a cadence (every N minutes,
+	// optionally within an hour range) reads as a comma-separated list of
+	// independent restrictions ("Every 15 minutes past the hour, between
+	// 09:00 and 17:00, Monday through Friday").
+	var head string
+	var rest []string
+	commaJoined := false
+
+	minutes := s.entity.Minute.Expanded()
+	hours := s.entity.Hour.Expanded()
+	switch {
+	case len(minutes) == 1 && len(hours) == 1:
+		head = tr.At(hours[0], minutes[0])
+	case isUniformStep(minutes):
+		commaJoined = true
+		step, _ := uniformStep(minutes)
+		if step == 0 {
+			step = 1
+		}
+		head = tr.EveryNMinutes(step)
+		if lo, hi, ok := contiguousBounds(hours); ok && !(lo == 0 && hi == 23) {
+			if lo == hi {
+				// A single restricted hour isn't a span; describe the
+				// one-hour window it covers instead of "between H:00 and
+				// H:00".
+				rest = append(rest, tr.HourRange(lo, (lo+1)%24))
+			} else {
+				rest = append(rest, tr.HourRange(lo, hi))
+			}
+		}
+	default:
+		head = tr.Generic(s.Cron())
+	}
+
+	if dom := s.entity.Dom.Expanded(); !s.entity.Dom.StarBit() && len(dom) == 1 {
+		rest = append(rest, tr.DayOfMonth(dom[0]))
+	}
+	if month := s.entity.Month.Expanded(); !s.entity.Month.StarBit() && len(month) == 1 {
+		rest = append(rest, tr.Month(monthName(month[0])))
+	}
+	if dow := canonicalDow(s.entity.DayOfWeek.Expanded()); !s.entity.DayOfWeek.StarBit() && len(dow) > 0 {
+		if lo, hi, ok := contiguousBounds(dow); ok && hi > lo {
+			rest = append(rest, tr.WeekdayRange(weekdayName(lo), weekdayName(hi)))
+		} else {
+			names := make([]string, len(dow))
+			for i, d := range dow {
+				names[i] = weekdayName(d)
+			}
+			rest = append(rest, tr.List(names))
+		}
+	}
+
+	clauses := append([]string{head}, rest...)
+	if commaJoined {
+		return tr.Join(clauses)
+	}
+	return strings.Join(clauses, " ")
+}
+
+// isUniformStep reports whether minutes is either a single value (step of
+// exactly one minute past the hour isn't meaningful for len==1, so this is
+// only used when len>1) or a uniform arithmetic progression.
+func isUniformStep(minutes []int) bool {
+	_, ok := uniformStep(minutes)
+	return ok
+}
@@ -0,0 +1,38 @@
+package checron
+
+import "testing"
+
+func TestSchedule_Describe(t *testing.T) {
+	cases := []struct {
+		spec string
+		want string
+	}{
+		{"15 3 1 1 *", "At 03:15 on day-of-month 1 in January"},
+		{"*/15 9-17 * * 1-5", "Every 15 minutes past the hour, between 09:00 and 17:00, Monday through Friday"},
+		{"7,22 9 * * *", `At the times matching "7,22 9 * * *"`},
+		{"*/15 9 * * *", "Every 15 minutes past the hour, between 09:00 and 10:00"},
+	}
+	for _, c := range cases {
+		sch := mustParseSchedule(t, c.spec)
+		if got := sch.Describe(); got != c.want {
+			t.Errorf("Describe(%q) = %q, want %q", c.spec, got, c.want)
+		}
+	}
+}
+
+func TestSchedule_Cron(t *testing.T) {
+	cases := []string{
+		"*/15 9-17 * * 1-5",
+		"30 4 1,15 * 5",
+		"0 0 1 1 *",
+		"* * * * *",
+		"7,22 9 * * *",
+		"* * * 3,12 *",
+	}
+	for _, spec := range cases {
+		sch := mustParseSchedule(t, spec)
+		if got := sch.Cron(); got != spec {
+			t.Errorf("Cron() = %q, want %q", got, spec)
+		}
+	}
+}
@@ -91,7 +91,7 @@ func fieldsN(str string, n int) (flds []string) {
 	return flds
 }
 
-var scheduleReg = regexp.MustCompile(`^(@\w+|(?:\S+\s+){5})(.*)$`)
+var scheduleReg = regexp.MustCompile(`^(@every\s+\S+|@\w+|(?:\S+\s+){5})(.*)$`)
 
 func (jo *Job) parse(hasUser bool) (err error) {
 	if m := scheduleReg.FindStringSubmatch(strings.TrimSpace(jo.raw)); len(m) == 3 {
@@ -0,0 +1,179 @@
+package checron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Songmu/checron/crontabparser"
+)
+
+// ParseOption controls which fields a Parser accepts and how it interprets
+// them, mirroring the robfig/cron parser options.
+type ParseOption int
+
+// Bits for ParseOption. Combine with | to build a Parser, e.g.
+// Second|Minute|Hour|Dom|Month|Dow for a 6-field parser.
+const (
+	Second ParseOption = 1 << iota
+	Minute
+	Hour
+	Dom
+	Month
+	Dow
+	DowOptional // Dow field may be omitted; "*" is assumed
+	Descriptor  // accept "@yearly", "@every 1h30m", etc.
+)
+
+// Parser parses crontab schedule strings according to a configured set of
+// ParseOptions.
+type Parser struct {
+	options ParseOption
+}
+
+// NewParser returns a *Parser configured with the given options.
+func NewParser(options ParseOption) *Parser {
+	return &Parser{options: options}
+}
+
+// defaultParser preserves the long-standing 5-field (minute hour dom month
+// dow) behavior, with descriptor support.
+var defaultParser = NewParser(Minute | Hour | Dom | Month | Dow | Descriptor)
+
+var parserFields = []struct {
+	name   string
+	option ParseOption
+}{
+	{"second", Second},
+	{"minute", Minute},
+	{"hour", Hour},
+	{"dom", Dom},
+	{"month", Month},
+	{"dow", Dow},
+}
+
+// fieldNames returns the ordered field names this Parser expects in a spec.
+func (p *Parser) fieldNames() []string {
+	var names []string
+	for _, f := range parserFields {
+		if p.options&f.option != 0 {
+			names = append(names, f.name)
+		}
+	}
+	if p.options&DowOptional != 0 && p.options&Dow == 0 {
+		names = append(names, "dow")
+	}
+	return names
+}
+
+// Parse parses spec according to the Parser's configured options and
+// returns the resulting *Schedule.
+func (p *Parser) Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if p.options&Descriptor != 0 && strings.HasPrefix(spec, "@") {
+		return p.parseDescriptor(spec)
+	}
+
+	names := p.fieldNames()
+	flds := strings.Fields(spec)
+	if p.options&DowOptional != 0 && len(flds) == len(names)-1 {
+		flds = append(flds, "*")
+	}
+	if len(flds) != len(names) {
+		return nil, fmt.Errorf("schedule: %q must have %d fields", spec, len(names))
+	}
+
+	raw := map[string]string{"minute": "*", "hour": "*", "dom": "*", "month": "*", "dow": "*"}
+	for i, name := range names {
+		raw[name] = flds[i]
+	}
+
+	return buildSchedule(raw)
+}
+
+// buildSchedule parses the raw per-field strings (defaulting to "*" for any
+// field the Parser didn't require) into a *Schedule. raw["second"] is left
+// unset unless the Parser was configured to accept a seconds field, so the
+// resulting Schedule.Second stays nil in that case.
+func buildSchedule(raw map[string]string) (*Schedule, error) {
+	minute, err := crontabparser.ParseEntity("minute", raw["minute"])
+	if err != nil {
+		return nil, err
+	}
+	hour, err := crontabparser.ParseEntity("hour", raw["hour"])
+	if err != nil {
+		return nil, err
+	}
+	dom, err := crontabparser.ParseEntity("dom", raw["dom"])
+	if err != nil {
+		return nil, err
+	}
+	month, err := crontabparser.ParseEntity("month", raw["month"])
+	if err != nil {
+		return nil, err
+	}
+	dow, err := crontabparser.ParseEntity("dow", raw["dow"])
+	if err != nil {
+		return nil, err
+	}
+	en := &crontabparser.Schedule{
+		Minute:    minute,
+		Hour:      hour,
+		Dom:       dom,
+		Month:     month,
+		DayOfWeek: dow,
+	}
+	if rawSecond, ok := raw["second"]; ok {
+		second, err := crontabparser.ParseEntity("second", rawSecond)
+		if err != nil {
+			return nil, err
+		}
+		en.Second = second
+	}
+	return &Schedule{entity: en}, nil
+}
+
+// cronDescriptors maps the well-known "@" shorthands (other than "@every")
+// to their equivalent 5-field crontab representation.
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+const everyPrefix = "@every "
+
+func (p *Parser) parseDescriptor(spec string) (*Schedule, error) {
+	lower := strings.ToLower(spec)
+	if strings.HasPrefix(lower, everyPrefix) {
+		dur, err := time.ParseDuration(strings.TrimSpace(spec[len(everyPrefix):]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every duration: %q: %s", spec, err)
+		}
+		if dur <= 0 {
+			return nil, fmt.Errorf("invalid @every duration: %q: must be positive", spec)
+		}
+		return &Schedule{interval: dur}, nil
+	}
+
+	expanded, ok := cronDescriptors[lower]
+	if !ok {
+		return nil, fmt.Errorf("unknown descriptor: %q", spec)
+	}
+	flds := strings.Fields(expanded)
+	raw := map[string]string{
+		"minute": flds[0],
+		"hour":   flds[1],
+		"dom":    flds[2],
+		"month":  flds[3],
+		"dow":    flds[4],
+	}
+	if p.options&Second != 0 {
+		raw["second"] = "0"
+	}
+	return buildSchedule(raw)
+}
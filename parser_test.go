@@ -0,0 +1,57 @@
+package checron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParser_SixFieldWithSeconds(t *testing.T) {
+	p := NewParser(Second | Minute | Hour | Dom | Month | Dow)
+	sch, err := p.Parse("30 0 4 1,15 * 5")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	from := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	got := sch.Next(from)
+	want := time.Date(2026, time.July, 1, 4, 0, 30, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, got, want)
+	}
+	if !sch.MatchTime(got) {
+		t.Errorf("MatchTime(Next(%v)) = false, want true", from)
+	}
+
+	prevGot := sch.Prev(want.Add(time.Minute))
+	if !prevGot.Equal(want) {
+		t.Errorf("Prev(%v) = %v, want %v", want.Add(time.Minute), prevGot, want)
+	}
+}
+
+func TestParser_DowOptional(t *testing.T) {
+	p := NewParser(Minute | Hour | Dom | Month | DowOptional)
+	if _, err := p.Parse("30 4 1 1"); err != nil {
+		t.Errorf("Parse without dow: %v", err)
+	}
+	if _, err := p.Parse("30 4 1 1 5"); err != nil {
+		t.Errorf("Parse with dow: %v", err)
+	}
+}
+
+func TestParser_Every(t *testing.T) {
+	sch, err := ParseSchedule("@every 1h30m")
+	if err != nil {
+		t.Fatalf("ParseSchedule: %v", err)
+	}
+	from := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	got := sch.Next(from)
+	want := from.Add(90 * time.Minute)
+	if !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+func TestParser_EveryInvalid(t *testing.T) {
+	if _, err := ParseSchedule("@every not-a-duration"); err == nil {
+		t.Error("expected error for invalid @every duration")
+	}
+}
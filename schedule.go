@@ -0,0 +1,302 @@
+package checron
+
+import (
+	"time"
+
+	"github.com/Songmu/checron/crontabparser"
+)
+
+// yearLimit bounds how far Next/Prev will search before giving up on an
+// impossible schedule (e.g. "0 0 31 2 *").
+const yearLimit = 5
+
+// Schedule represents a parsed crontab schedule (minute hour dom month dow,
+// optionally with seconds), or a fixed "@every" interval.
+type Schedule struct {
+	entity   *crontabparser.Schedule
+	interval time.Duration
+	loc      *time.Location
+}
+
+// ParseSchedule parses a crontab schedule string, either a 5-field spec or
+// one of the "@yearly"/"@monthly"/"@every"/... descriptors, and returns the
+// *Schedule. It is equivalent to NewParser(Minute|Hour|Dom|Month|Dow|Descriptor).Parse(raw).
+func ParseSchedule(raw string) (*Schedule, error) {
+	return defaultParser.Parse(raw)
+}
+
+// In sets the time.Location used when computing Next/Prev and returns the
+// Schedule for chaining.
+func (s *Schedule) In(loc *time.Location) *Schedule {
+	s.loc = loc
+	return s
+}
+
+func (s *Schedule) location() *time.Location {
+	if s.loc == nil {
+		return time.Local
+	}
+	return s.loc
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// dateAt builds a time in loc for the given wall-clock components, nudging
+// forward by the DST gap if that wall time was skipped entirely (e.g.
+// 02:30 on a spring-forward day, where the clock jumps straight from 01:59
+// to 03:00).
+func dateAt(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, min, sec, 0, loc)
+	if t.Year() == year && t.Month() == month && t.Day() == day && t.Hour() != hour {
+		t = t.Add(time.Duration(hour-t.Hour()) * time.Hour)
+	}
+	return t
+}
+
+// nextInList returns the smallest value in the sorted list that is >= cur.
+// overflow is true when no such value exists, in which case val is the
+// list's minimum, to be used after rolling the parent field forward.
+func nextInList(list []int, cur int) (val int, overflow bool) {
+	for _, v := range list {
+		if v >= cur {
+			return v, false
+		}
+	}
+	return list[0], true
+}
+
+// prevInList returns the largest value in the sorted list that is <= cur.
+// underflow is true when no such value exists, in which case val is the
+// list's maximum, to be used after rolling the parent field backward.
+func prevInList(list []int, cur int) (val int, underflow bool) {
+	for i := len(list) - 1; i >= 0; i-- {
+		if list[i] <= cur {
+			return list[i], false
+		}
+	}
+	return list[len(list)-1], true
+}
+
+// MatchTime reports whether t falls on an instant the schedule fires,
+// ANDing across all fields in constant time. It always returns false for
+// an "@every" interval schedule, which has no fixed grid to test against.
+func (s *Schedule) MatchTime(t time.Time) bool {
+	if s.interval > 0 {
+		return false
+	}
+	t = t.In(s.location())
+	if s.entity.Second != nil && !s.entity.Second.Match(t.Second()) {
+		return false
+	}
+	return s.entity.Minute.Match(t.Minute()) &&
+		s.entity.Hour.Match(t.Hour()) &&
+		s.entity.Month.Match(int(t.Month())) &&
+		s.domDowMatch(t, t.Day())
+}
+
+// domDowMatch reports whether day matches the schedule's day-of-month and
+// day-of-week fields, using cron's historical OR-semantics: if both fields
+// are restricted, either may match; if only one is restricted, that one
+// governs.
+func (s *Schedule) domDowMatch(t time.Time, day int) bool {
+	domStar := s.entity.Dom.StarBit()
+	dowStar := s.entity.DayOfWeek.StarBit()
+	weekday := int(time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday())
+
+	domOK := s.entity.Dom.Match(day)
+	dowOK := s.entity.DayOfWeek.Match(weekday)
+	switch {
+	case domStar && dowStar:
+		return true
+	case domStar:
+		return dowOK
+	case dowStar:
+		return domOK
+	default:
+		return domOK || dowOK
+	}
+}
+
+// nextDay returns the smallest day in t's month that is >= t.Day() and
+// satisfies domDowMatch. overflow is true when the month has no more such
+// days.
+func (s *Schedule) nextDay(t time.Time) (day int, overflow bool) {
+	last := daysInMonth(t.Year(), t.Month())
+	for d := t.Day(); d <= last; d++ {
+		if s.domDowMatch(t, d) {
+			return d, false
+		}
+	}
+	return 0, true
+}
+
+// prevDay returns the largest day in t's month that is <= t.Day() and
+// satisfies domDowMatch. underflow is true when the month has no such day.
+func (s *Schedule) prevDay(t time.Time) (day int, underflow bool) {
+	for d := t.Day(); d >= 1; d-- {
+		if s.domDowMatch(t, d) {
+			return d, false
+		}
+	}
+	return 0, true
+}
+
+// Next returns the first instant strictly after t at which the schedule
+// fires. It returns the zero time.Time if no such instant exists within
+// yearLimit years (e.g. "0 0 31 2 *").
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.interval > 0 {
+		return t.Add(s.interval)
+	}
+
+	loc := s.location()
+	if s.entity.Second != nil {
+		t = t.In(loc).Truncate(time.Second).Add(time.Second)
+	} else {
+		t = t.In(loc).Truncate(time.Minute).Add(time.Minute)
+	}
+	yearCap := t.Year() + yearLimit
+
+	for {
+		if t.Year() > yearCap {
+			return time.Time{}
+		}
+
+		if m, overflow := nextInList(s.entity.Month.Expanded(), int(t.Month())); overflow {
+			t = dateAt(t.Year()+1, time.January, 1, 0, 0, 0, loc)
+			continue
+		} else if m != int(t.Month()) {
+			t = dateAt(t.Year(), time.Month(m), 1, 0, 0, 0, loc)
+			continue
+		}
+
+		if d, overflow := s.nextDay(t); overflow {
+			t = dateAt(t.Year(), t.Month()+1, 1, 0, 0, 0, loc)
+			continue
+		} else if d != t.Day() {
+			t = dateAt(t.Year(), t.Month(), d, 0, 0, 0, loc)
+			continue
+		}
+
+		if h, overflow := nextInList(s.entity.Hour.Expanded(), t.Hour()); overflow {
+			t = dateAt(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, loc)
+			continue
+		} else if h != t.Hour() {
+			t = dateAt(t.Year(), t.Month(), t.Day(), h, 0, 0, loc)
+			continue
+		}
+
+		if mi, overflow := nextInList(s.entity.Minute.Expanded(), t.Minute()); overflow {
+			t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, loc)
+			continue
+		} else if mi != t.Minute() {
+			t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour(), mi, 0, loc)
+			continue
+		}
+
+		if s.entity.Second != nil {
+			if se, overflow := nextInList(s.entity.Second.Expanded(), t.Second()); overflow {
+				t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, loc)
+				continue
+			} else if se != t.Second() {
+				t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), se, loc)
+				continue
+			}
+		}
+
+		return t
+	}
+}
+
+// Prev returns the last instant strictly before t at which the schedule
+// fires. It returns the zero time.Time if no such instant exists within
+// yearLimit years.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	if s.interval > 0 {
+		return t.Add(-s.interval)
+	}
+
+	loc := s.location()
+	// secCap seeds the second component whenever we descend into a fresh
+	// minute while searching backward: 59 so the Second step below (when
+	// there is one) searches for the largest matching second in it, 0 (the
+	// historical behavior) when there's no Second field to constrain it.
+	secCap := 0
+	if s.entity.Second != nil {
+		secCap = 59
+		t = t.In(loc).Truncate(time.Second).Add(-time.Second)
+	} else {
+		t = t.In(loc).Truncate(time.Minute).Add(-time.Minute)
+	}
+	yearFloor := t.Year() - yearLimit
+
+	for {
+		if t.Year() < yearFloor {
+			return time.Time{}
+		}
+
+		if m, underflow := prevInList(s.entity.Month.Expanded(), int(t.Month())); underflow {
+			t = dateAt(t.Year()-1, time.December, 31, 23, 59, secCap, loc)
+			continue
+		} else if m != int(t.Month()) {
+			last := daysInMonth(t.Year(), time.Month(m))
+			t = dateAt(t.Year(), time.Month(m), last, 23, 59, secCap, loc)
+			continue
+		}
+
+		if d, underflow := s.prevDay(t); underflow {
+			// day 0 of this month normalizes to the last day of the
+			// previous month.
+			t = dateAt(t.Year(), t.Month(), 0, 23, 59, secCap, loc)
+			continue
+		} else if d != t.Day() {
+			t = dateAt(t.Year(), t.Month(), d, 23, 59, secCap, loc)
+			continue
+		}
+
+		if h, underflow := prevInList(s.entity.Hour.Expanded(), t.Hour()); underflow {
+			t = dateAt(t.Year(), t.Month(), t.Day()-1, 23, 59, secCap, loc)
+			continue
+		} else if h != t.Hour() {
+			t = dateAt(t.Year(), t.Month(), t.Day(), h, 59, secCap, loc)
+			continue
+		}
+
+		if mi, underflow := prevInList(s.entity.Minute.Expanded(), t.Minute()); underflow {
+			t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour()-1, 59, secCap, loc)
+			continue
+		} else if mi != t.Minute() {
+			t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour(), mi, secCap, loc)
+			continue
+		}
+
+		if s.entity.Second != nil {
+			if se, underflow := prevInList(s.entity.Second.Expanded(), t.Second()); underflow {
+				t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()-1, 59, loc)
+				continue
+			} else if se != t.Second() {
+				t = dateAt(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), se, loc)
+				continue
+			}
+		}
+
+		return t
+	}
+}
+
+// NextN returns the first n instants after t at which the schedule fires.
+// It stops early if Next reports that no further instant exists.
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	out := make([]time.Time, 0, n)
+	cur := t
+	for i := 0; i < n; i++ {
+		cur = s.Next(cur)
+		if cur.IsZero() {
+			break
+		}
+		out = append(out, cur)
+	}
+	return out
+}
@@ -0,0 +1,106 @@
+package checron
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParseSchedule(t *testing.T, raw string) *Schedule {
+	t.Helper()
+	sch, err := ParseSchedule(raw)
+	if err != nil {
+		t.Fatalf("ParseSchedule(%q): %v", raw, err)
+	}
+	return sch
+}
+
+func TestSchedule_Next(t *testing.T) {
+	sch := mustParseSchedule(t, "30 4 1,15 * 5")
+	from := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	got := sch.Next(from)
+	want := time.Date(2026, time.July, 1, 4, 30, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+func TestSchedule_Next_NoSecondsField(t *testing.T) {
+	sch := mustParseSchedule(t, "*/15 9-17 * * 1-5")
+	from := time.Date(2026, time.July, 1, 9, 15, 23, 0, time.UTC)
+	got := sch.Next(from)
+	want := time.Date(2026, time.July, 1, 9, 30, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+func TestSchedule_Next_DSTSpringForward(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	// US spring-forward in 2026 is March 8, 02:00 -> 03:00.
+	sch := mustParseSchedule(t, "30 2 * * *").In(loc)
+	from := time.Date(2026, time.March, 7, 12, 0, 0, 0, loc)
+	got := sch.Next(from)
+	if got.Day() != 9 || got.Hour() != 2 || got.Minute() != 30 {
+		t.Errorf("Next(%v) = %v, want next occurrence on March 9 (March 8's 02:30 does not exist)", from, got)
+	}
+}
+
+func TestSchedule_Next_LeapYear(t *testing.T) {
+	sch := mustParseSchedule(t, "0 0 29 2 *")
+	from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	got := sch.Next(from)
+	want := time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+func TestSchedule_Next_Impossible(t *testing.T) {
+	sch := mustParseSchedule(t, "0 0 31 2 *")
+	got := sch.Next(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if !got.IsZero() {
+		t.Errorf("Next() = %v, want zero time for impossible schedule", got)
+	}
+}
+
+func TestSchedule_Prev(t *testing.T) {
+	sch := mustParseSchedule(t, "30 4 1,15 * 5")
+	from := time.Date(2026, time.July, 16, 0, 0, 0, 0, time.UTC)
+	got := sch.Prev(from)
+	want := time.Date(2026, time.July, 15, 4, 30, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Prev(%v) = %v, want %v", from, got, want)
+	}
+}
+
+func TestSchedule_MatchTime(t *testing.T) {
+	sch := mustParseSchedule(t, "30 4 1,15 * 5")
+	if !sch.MatchTime(time.Date(2026, time.July, 1, 4, 30, 0, 0, time.UTC)) {
+		t.Error("MatchTime() = false, want true")
+	}
+	if sch.MatchTime(time.Date(2026, time.July, 1, 4, 31, 0, 0, time.UTC)) {
+		t.Error("MatchTime() = true, want false")
+	}
+}
+
+func TestSchedule_NextN(t *testing.T) {
+	sch := mustParseSchedule(t, "0 0 1 * *")
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	got := sch.NextN(from, 3)
+	want := []time.Time{
+		time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextN returned %d results, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if !got[i].Equal(w) {
+			t.Errorf("NextN()[%d] = %v, want %v", i, got[i], w)
+		}
+	}
+}
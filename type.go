@@ -0,0 +1,9 @@
+package checron
+
+// Type represents the kind of entry parsed from a crontab line.
+type Type int
+
+// TypeJob indicates the entry is a schedule entry (a job).
+const (
+	TypeJob Type = iota
+)